@@ -0,0 +1,63 @@
+package deque
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+)
+
+// Marshals the deque to a JSON array in head-to-tail order
+func (self *Deque[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(self.ToSlice())
+}
+
+// Unmarshals a JSON array into the deque, replacing its current contents.
+// The receiver must already be constructed via NewDeque/NewUnlimitedDeque so its
+// capacity is set; if the array is bigger than a fixed capacity, elements are
+// evicted from the left as they would be with Extend
+func (self *Deque[T]) UnmarshalJSON(data []byte) error {
+	var values []T
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	self.reset()
+	for _, value := range values {
+		self.append(value)
+	}
+
+	return nil
+}
+
+// Encodes the deque for gob as a slice of its elements in head-to-tail order
+func (self *Deque[T]) GobEncode() ([]byte, error) {
+	buf := new(bytes.Buffer)
+	if err := gob.NewEncoder(buf).Encode(self.ToSlice()); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Decodes a gob-encoded slice into the deque, replacing its current contents.
+// The receiver must already be constructed via NewDeque/NewUnlimitedDeque so its
+// capacity is set; if the slice is bigger than a fixed capacity, elements are
+// evicted from the left as they would be with Extend
+func (self *Deque[T]) GobDecode(data []byte) error {
+	var values []T
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&values); err != nil {
+		return err
+	}
+
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	self.reset()
+	for _, value := range values {
+		self.append(value)
+	}
+
+	return nil
+}
@@ -0,0 +1,75 @@
+package deque
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"slices"
+	"testing"
+)
+
+func TestMarshalJSON(t *testing.T) {
+	q := NewDequeFromSeq(slices.Values([]int{1, 2, 3}), -1)
+
+	data, err := json.Marshal(q)
+	if err != nil {
+		t.Fatalf("Expected MarshalJSON() to succeed, got error: %v", err)
+	}
+	if string(data) != "[1,2,3]" {
+		t.Errorf("Expected JSON to be [1,2,3], got %s", data)
+	}
+}
+
+func TestUnmarshalJSON(t *testing.T) {
+	q := NewUnlimitedDeque[int]()
+
+	if err := json.Unmarshal([]byte("[1,2,3]"), q); err != nil {
+		t.Fatalf("Expected UnmarshalJSON() to succeed, got error: %v", err)
+	}
+
+	expected := []int{1, 2, 3}
+	if q.GetLen() != len(expected) {
+		t.Fatalf("Expected len to be %d, got %d", len(expected), q.GetLen())
+	}
+	for i, v := range expected {
+		if got := q.Peek(i); got != v {
+			t.Errorf("Expected value at index %d to be %d, got %d", i, v, got)
+		}
+	}
+}
+
+func TestUnmarshalJSONOverflow(t *testing.T) {
+	q := NewDeque[int](2)
+
+	if err := json.Unmarshal([]byte("[1,2,3]"), q); err != nil {
+		t.Fatalf("Expected UnmarshalJSON() to succeed, got error: %v", err)
+	}
+
+	expected := []int{2, 3}
+	if q.GetLen() != len(expected) {
+		t.Fatalf("Expected len to be %d, got %d", len(expected), q.GetLen())
+	}
+	for i, v := range expected {
+		if got := q.Peek(i); got != v {
+			t.Errorf("Expected value at index %d to be %d, got %d", i, v, got)
+		}
+	}
+}
+
+func TestGobRoundTrip(t *testing.T) {
+	q := NewDequeFromSeq(slices.Values([]int{1, 2, 3}), -1)
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(q); err != nil {
+		t.Fatalf("Expected GobEncode() to succeed, got error: %v", err)
+	}
+
+	decoded := NewUnlimitedDeque[int]()
+	if err := gob.NewDecoder(&buf).Decode(decoded); err != nil {
+		t.Fatalf("Expected GobDecode() to succeed, got error: %v", err)
+	}
+
+	if !q.Equal(decoded) {
+		t.Errorf("Expected decoded deque to equal the original, got %v vs %v", q.ToSlice(), decoded.ToSlice())
+	}
+}
@@ -0,0 +1,244 @@
+package deque
+
+import (
+	"fmt"
+	"iter"
+	"runtime"
+	"sync/atomic"
+)
+
+// Double ended queue for contention-bound workloads.
+// Internally sharded into one ring-buffer-backed Deque[T] per shard, each guarded
+// by its own mutex; Append/AppendLeft/TryPop/TryPopLeft route to a shard
+// round-robin, so unrelated goroutines rarely block on the same lock. This trades
+// Deque's strict FIFO ordering across concurrent callers for reduced lock
+// contention: each shard stays internally ordered, but operations are not
+// globally ordered across shards. Use Deque unless profiling shows mutex
+// contention on it is the actual bottleneck
+type ConcurrentDeque[T comparable] struct {
+	shards     []*Deque[T]
+	pushCursor atomic.Uint64
+	popCursor  atomic.Uint64
+	length     atomic.Int64
+	capacity   int
+}
+
+func (self *ConcurrentDeque[T]) String() string {
+	return fmt.Sprintf("ConcurrentDeque{capacity:%v, values:%v}", self.capacity, self.ToSlice())
+}
+
+// Creates a new concurrent deque with the given capacity.
+// Capacity -1 creates a deque of unlimited size. The deque is split into one
+// shard per available CPU (rounded up to a power of two); capacity is enforced
+// globally via a shared counter rather than per shard, so GetCapacity()/IsFull()
+// report the exact bound requested rather than an approximation
+func NewConcurrentDeque[T comparable](capacity int) *ConcurrentDeque[T] {
+	numShards := nextPowerOfTwo(max(runtime.GOMAXPROCS(0), 1))
+
+	// Capacity 0 is special-cased onto every shard directly: it needs no
+	// eviction bookkeeping since the shards themselves never accept a push
+	shardCapacity := -1
+	if capacity == 0 {
+		shardCapacity = 0
+	}
+
+	shards := make([]*Deque[T], numShards)
+	for i := range shards {
+		shards[i] = NewDeque[T](shardCapacity)
+	}
+
+	return &ConcurrentDeque[T]{shards: shards, capacity: capacity}
+}
+
+func (self *ConcurrentDeque[T]) mask() uint64 {
+	return uint64(len(self.shards) - 1)
+}
+
+// Picks the next shard index for a push/pop, round-robin
+func (self *ConcurrentDeque[T]) nextShardIdx(cursor *atomic.Uint64) uint64 {
+	return (cursor.Add(1) - 1) & self.mask()
+}
+
+// Returns current size of the deque
+func (self *ConcurrentDeque[T]) GetLen() int {
+	return int(self.length.Load())
+}
+
+// Checks if the deque is empty
+func (self *ConcurrentDeque[T]) IsEmpty() bool {
+	return self.GetLen() == 0
+}
+
+// Returns deque capacity
+func (self *ConcurrentDeque[T]) GetCapacity() int {
+	return self.capacity
+}
+
+// Checks if the deque is of unlimited capacity
+func (self *ConcurrentDeque[T]) IsUnlimited() bool {
+	return self.GetCapacity() < 0
+}
+
+// Checks if the deque is full
+func (self *ConcurrentDeque[T]) IsFull() bool {
+	return !self.IsUnlimited() && self.GetLen() >= self.GetCapacity()
+}
+
+// Appends a new element to the right end of the deque, routed to a shard
+// round-robin. If the deque is at capacity, an element is popped from its left end
+func (self *ConcurrentDeque[T]) Append(value T) {
+	self.shards[self.nextShardIdx(&self.pushCursor)].Append(value)
+	if self.capacity == 0 {
+		return
+	}
+	self.length.Add(1)
+	self.evictIfOverflowing(self.tryPopLeft)
+}
+
+// Appends a new element to the left end of the deque, routed to a shard
+// round-robin. If the deque is at capacity, an element is popped from its right end
+func (self *ConcurrentDeque[T]) AppendLeft(value T) {
+	self.shards[self.nextShardIdx(&self.pushCursor)].AppendLeft(value)
+	if self.capacity == 0 {
+		return
+	}
+	self.length.Add(1)
+	self.evictIfOverflowing(self.tryPop)
+}
+
+// Pops from the opposite end until the tracked length is back within capacity,
+// bounding the global size the way a single shard bounds itself in Deque
+func (self *ConcurrentDeque[T]) evictIfOverflowing(evict func() (T, error)) {
+	if self.IsUnlimited() {
+		return
+	}
+	for self.length.Load() > int64(self.capacity) {
+		if _, err := evict(); err != nil {
+			return
+		}
+	}
+}
+
+// Removes an element from the right end and returns it.
+// Tries a round-robin shard first, then falls back to scanning the rest;
+// returns an error only once every shard is empty
+func (self *ConcurrentDeque[T]) TryPop() (T, error) {
+	return self.tryPop()
+}
+
+func (self *ConcurrentDeque[T]) tryPop() (T, error) {
+	start := self.nextShardIdx(&self.popCursor)
+	for i := range uint64(len(self.shards)) {
+		shard := self.shards[(start+i)&self.mask()]
+		if value, err := shard.TryPop(); err == nil {
+			self.length.Add(-1)
+			return value, nil
+		}
+	}
+	var value T
+	return value, &PopError{}
+}
+
+// Removes an element from the left end and returns it.
+// Tries a round-robin shard first, then falls back to scanning the rest;
+// returns an error only once every shard is empty
+func (self *ConcurrentDeque[T]) TryPopLeft() (T, error) {
+	return self.tryPopLeft()
+}
+
+func (self *ConcurrentDeque[T]) tryPopLeft() (T, error) {
+	start := self.nextShardIdx(&self.popCursor)
+	for i := range uint64(len(self.shards)) {
+		shard := self.shards[(start+i)&self.mask()]
+		if value, err := shard.TryPopLeft(); err == nil {
+			self.length.Add(-1)
+			return value, nil
+		}
+	}
+	var value T
+	return value, &PopError{}
+}
+
+// Removes all elements from the deque
+func (self *ConcurrentDeque[T]) Clear() {
+	for _, shard := range self.shards {
+		shard.Clear()
+	}
+	self.length.Store(0)
+}
+
+// Returns the number of occurrences of the value given in the deque
+func (self *ConcurrentDeque[T]) Count(value T) int {
+	total := 0
+	for _, shard := range self.shards {
+		total += shard.Count(value)
+	}
+	return total
+}
+
+// Returns an element at the given index or error if there's no element at such index.
+// Indexing stitches shards together in shard order; see Values for the ordering caveat
+func (self *ConcurrentDeque[T]) TryPeek(index int) (T, error) {
+	var value T
+
+	if index < 0 {
+		return value, &PeekError{index}
+	}
+
+	remaining := index
+	for _, shard := range self.shards {
+		shardLen := shard.GetLen()
+		if remaining < shardLen {
+			return shard.TryPeek(remaining)
+		}
+		remaining -= shardLen
+	}
+
+	return value, &PeekError{index}
+}
+
+// Returns an element at the given index
+func (self *ConcurrentDeque[T]) Peek(index int) T {
+	value, err := self.TryPeek(index)
+	if err != nil {
+		panic(err)
+	}
+	return value
+}
+
+// Returns iterator over deque values.
+// Takes an independent, consistent snapshot of each shard and stitches them in
+// shard order: FIFO within a shard, but not a strict global FIFO order across shards
+func (self *ConcurrentDeque[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, shard := range self.shards {
+			for _, value := range shard.ToSlice() {
+				if !yield(value) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Returns iterator over deque values and their indices
+func (self *ConcurrentDeque[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := 0
+		for value := range self.Values() {
+			if !yield(i, value) {
+				return
+			}
+			i++
+		}
+	}
+}
+
+// Returns a new slice containing the deque's elements, in the same order as Values
+func (self *ConcurrentDeque[T]) ToSlice() []T {
+	values := make([]T, 0, self.GetLen())
+	for value := range self.Values() {
+		values = append(values, value)
+	}
+	return values
+}
@@ -0,0 +1,213 @@
+package deque
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+)
+
+func TestNewConcurrentDeque(t *testing.T) {
+	testCases := []struct{ capacity int }{
+		{-1},
+		{0},
+		{10},
+	}
+	for _, tc := range testCases {
+		t.Run(
+			fmt.Sprintf("Capacity/%d", tc.capacity),
+			func(t *testing.T) {
+				q := NewConcurrentDeque[int](tc.capacity)
+
+				if q.GetCapacity() != tc.capacity {
+					t.Errorf("Expected capacity to be %d, got %d", tc.capacity, q.GetCapacity())
+				}
+				if q.GetLen() != 0 {
+					t.Errorf("Expected len to be 0, got %d", q.GetLen())
+				}
+				if len(q.shards) == 0 {
+					t.Errorf("Expected at least one shard")
+				}
+			},
+		)
+	}
+}
+
+func TestConcurrentDequeAppendAndPop(t *testing.T) {
+	const TOTAL_ITEMS int = 100
+
+	q := NewConcurrentDeque[int](-1)
+	for i := range TOTAL_ITEMS {
+		q.Append(i)
+	}
+	if q.GetLen() != TOTAL_ITEMS {
+		t.Fatalf("Expected len to be %d, got %d", TOTAL_ITEMS, q.GetLen())
+	}
+
+	seen := make(map[int]bool, TOTAL_ITEMS)
+	for range TOTAL_ITEMS {
+		v, err := q.TryPop()
+		if err != nil {
+			t.Fatalf("Expected TryPop() to succeed, got error: %v", err)
+		}
+		if seen[v] {
+			t.Errorf("Value %d popped more than once", v)
+		}
+		seen[v] = true
+	}
+	if !q.IsEmpty() {
+		t.Errorf("Expected deque to be empty after popping everything")
+	}
+	if _, err := q.TryPop(); err == nil {
+		t.Errorf("Expected TryPop() to error out on an empty deque")
+	}
+}
+
+func TestConcurrentDequeAppendLeftAndPopLeft(t *testing.T) {
+	const TOTAL_ITEMS int = 100
+
+	q := NewConcurrentDeque[int](-1)
+	for i := range TOTAL_ITEMS {
+		q.AppendLeft(i)
+	}
+
+	seen := make(map[int]bool, TOTAL_ITEMS)
+	for range TOTAL_ITEMS {
+		v, err := q.TryPopLeft()
+		if err != nil {
+			t.Fatalf("Expected TryPopLeft() to succeed, got error: %v", err)
+		}
+		seen[v] = true
+	}
+	if len(seen) != TOTAL_ITEMS {
+		t.Errorf("Expected to see %d distinct values, got %d", TOTAL_ITEMS, len(seen))
+	}
+}
+
+func TestConcurrentDequeIsFull(t *testing.T) {
+	const CAPACITY int = 20
+
+	q := NewConcurrentDeque[int](CAPACITY)
+	for i := range CAPACITY * 2 {
+		q.Append(i)
+	}
+
+	if q.GetLen() != CAPACITY {
+		t.Errorf("Expected len to stay bounded by capacity %d, got %d", CAPACITY, q.GetLen())
+	}
+	if !q.IsFull() {
+		t.Errorf("Expected deque to report full once len reaches capacity")
+	}
+}
+
+// Regression test: capacity must be enforced globally, not per shard. With
+// numShards > 1 and a small capacity, dividing the capacity across shards
+// (and rounding up) used to let the overall length exceed the requested
+// capacity by up to numShards-1 elements before anything was evicted
+func TestConcurrentDequeSmallCapacityAcrossManyShards(t *testing.T) {
+	const CAPACITY int = 1
+
+	q := NewConcurrentDeque[int](CAPACITY)
+	for i := range 8 * len(q.shards) {
+		q.Append(i)
+		if q.GetLen() > CAPACITY {
+			t.Fatalf("Expected len to never exceed capacity %d, got %d", CAPACITY, q.GetLen())
+		}
+	}
+}
+
+func TestConcurrentDequeZeroCapacity(t *testing.T) {
+	q := NewConcurrentDeque[int](0)
+
+	for _, shard := range q.shards {
+		if shard.GetCapacity() != 0 {
+			t.Errorf("Expected every shard to have capacity 0, got %d", shard.GetCapacity())
+		}
+	}
+
+	for i := range 10 {
+		q.Append(i)
+		q.AppendLeft(i)
+	}
+
+	if q.GetLen() != 0 {
+		t.Errorf("Expected a deque of capacity 0 to stay empty, got len %d", q.GetLen())
+	}
+	if !q.IsFull() {
+		t.Errorf("Expected a deque of capacity 0 to always report full")
+	}
+}
+
+func TestConcurrentDequeConcurrentAppend(t *testing.T) {
+	const GOROUTINES int = 8
+	const ITEMS_PER_GOROUTINE int = 500
+
+	q := NewConcurrentDeque[int](-1)
+
+	var wg sync.WaitGroup
+	for range GOROUTINES {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range ITEMS_PER_GOROUTINE {
+				q.Append(i)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if q.GetLen() != GOROUTINES*ITEMS_PER_GOROUTINE {
+		t.Errorf("Expected len to be %d, got %d", GOROUTINES*ITEMS_PER_GOROUTINE, q.GetLen())
+	}
+}
+
+func TestConcurrentDequeValues(t *testing.T) {
+	const TOTAL_ITEMS int = 16
+
+	q := NewConcurrentDeque[int](-1)
+	for i := range TOTAL_ITEMS {
+		q.Append(i)
+	}
+
+	seen := make(map[int]bool, TOTAL_ITEMS)
+	for i, v := range q.All() {
+		seen[v] = true
+		if got := q.Peek(i); got != v {
+			t.Errorf("Expected Peek(%d) to match the iterator value %d, got %d", i, v, got)
+		}
+	}
+	if len(seen) != TOTAL_ITEMS {
+		t.Errorf("Expected to see %d distinct values, got %d", TOTAL_ITEMS, len(seen))
+	}
+
+	slice := q.ToSlice()
+	if len(slice) != TOTAL_ITEMS {
+		t.Errorf("Expected ToSlice() to return %d values, got %d", TOTAL_ITEMS, len(slice))
+	}
+}
+
+func TestConcurrentDequeCount(t *testing.T) {
+	q := NewConcurrentDeque[int](-1)
+	for range 5 {
+		q.Append(7)
+	}
+	q.Append(8)
+
+	if q.Count(7) != 5 {
+		t.Errorf("Expected Count(7) to be 5, got %d", q.Count(7))
+	}
+	if q.Count(8) != 1 {
+		t.Errorf("Expected Count(8) to be 1, got %d", q.Count(8))
+	}
+}
+
+func TestConcurrentDequeClear(t *testing.T) {
+	q := NewConcurrentDeque[int](-1)
+	for i := range 10 {
+		q.Append(i)
+	}
+	q.Clear()
+
+	if !q.IsEmpty() {
+		t.Errorf("Expected deque to be empty after Clear()")
+	}
+}
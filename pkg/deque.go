@@ -3,24 +3,20 @@ package deque
 import (
 	"fmt"
 	"iter"
+	"slices"
 	"sync"
 )
 
-// Represents a node in deque
-type node[T any] struct {
-	value T
-	next  *node[T]
-	prev  *node[T]
-}
-
-func (self *node[T]) String() string {
-	return fmt.Sprintf("Node{value:%v, next:%v}", self.value, self.next)
-}
+// Minimum size of the backing buffer for an unlimited deque.
+// Kept a power of two so index wrapping can use a bitmask instead of modulo
+const minBufferSize = 16
 
-// Double ended queue
+// Double ended queue.
+// Backed by a ring buffer: a single slice whose length is always a power of
+// two, with head/tail tracked as wrapping indices into it
 type Deque[T comparable] struct {
-	head     *node[T]
-	tail     *node[T]
+	buf      []T
+	head     int
 	len      int
 	capacity int
 	mutex    sync.Mutex
@@ -29,13 +25,43 @@ type Deque[T comparable] struct {
 func (self *Deque[T]) String() string {
 	self.mutex.Lock()
 	defer self.mutex.Unlock()
-	return fmt.Sprintf("Deque{capacity:%v, head:%v, tail:%v}", self.capacity, self.head, self.tail)
+
+	values := make([]T, self.len)
+	for i := range self.len {
+		values[i] = self.buf[(self.head+i)&self.mask()]
+	}
+	return fmt.Sprintf("Deque{capacity:%v, values:%v}", self.capacity, values)
+}
+
+// Returns the smallest power of two that is >= n, n must be > 0
+func nextPowerOfTwo(n int) int {
+	p := 1
+	for p < n {
+		p <<= 1
+	}
+	return p
+}
+
+// Returns the backing buffer size to use for a deque of the given fixed capacity.
+// The buffer needs one slot of headroom beyond capacity so that an overflowing
+// Append/AppendLeft can be written before the opposite end is popped
+func bufferSizeForCapacity(capacity int) int {
+	if capacity <= 0 {
+		return 1
+	}
+	return nextPowerOfTwo(capacity + 1)
 }
 
 // Creates a new deque with the given capacity.
 // Capacity -1 creates a deque of unlimited size
 func NewDeque[T comparable](capacity int) *Deque[T] {
-	return &Deque[T]{nil, nil, 0, capacity, sync.Mutex{}}
+	var bufSize int
+	if capacity < 0 {
+		bufSize = minBufferSize
+	} else {
+		bufSize = bufferSizeForCapacity(capacity)
+	}
+	return &Deque[T]{make([]T, bufSize), 0, 0, capacity, sync.Mutex{}}
 }
 
 // Creates a new deque with unlimited capacity
@@ -82,14 +108,19 @@ func (self *Deque[T]) isOverflowing() bool {
 	return !self.IsUnlimited() && self.GetLen() > self.GetCapacity()
 }
 
+// NOTE: assumes the mutex is acquired
+func (self *Deque[T]) mask() int {
+	return len(self.buf) - 1
+}
+
 // Returns iterator over deque values
 func (self *Deque[T]) Values() iter.Seq[T] {
 	return func(yield func(T) bool) {
 		self.mutex.Lock()
 		defer self.mutex.Unlock()
 
-		for item := self.head; item != nil; item = item.next {
-			if !yield(item.value) {
+		for i := range self.len {
+			if !yield(self.buf[(self.head+i)&self.mask()]) {
 				return
 			}
 		}
@@ -109,44 +140,128 @@ func (self *Deque[T]) All() iter.Seq2[int, T] {
 	}
 }
 
+// NOTE: assumes the mutex is acquired
+func (self *Deque[T]) validateRange(start, end int) {
+	if start < 0 || end > self.GetLen() || start > end {
+		panic(fmt.Sprintf("deque: invalid range [%d:%d) for deque of len %d", start, end, self.GetLen()))
+	}
+}
+
+// Returns an iterator over the elements and their indices in [start, end).
+// Panics if the range is out of bounds
+func (self *Deque[T]) Range(start, end int) iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		self.mutex.Lock()
+		defer self.mutex.Unlock()
+
+		self.validateRange(start, end)
+
+		for i := start; i < end; i++ {
+			if !yield(i, self.buf[(self.head+i)&self.mask()]) {
+				return
+			}
+		}
+	}
+}
+
+// Returns an iterator over the elements in [start, end).
+// Panics if the range is out of bounds
+func (self *Deque[T]) RangeValues(start, end int) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, value := range self.Range(start, end) {
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
+
 // Creates a shallow copy of the deque
 func (self *Deque[T]) Copy() *Deque[T] {
-	rv := NewDeque[T](self.capacity)
-	rv.mutex.Lock()
-	defer rv.mutex.Unlock()
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	buf := make([]T, len(self.buf))
+	copy(buf, self.buf)
+
+	return &Deque[T]{buf, self.head, self.len, self.capacity, sync.Mutex{}}
+}
+
+// Alias for Copy, matching idiomatic Go naming for clone methods
+func (self *Deque[T]) Clone() *Deque[T] {
+	return self.Copy()
+}
 
-	for value := range self.Values() {
-		// Avoid mutex overhead
-		rv.append(value)
+// Returns a new slice containing the deque's elements in head-to-tail order
+func (self *Deque[T]) ToSlice() []T {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	values := make([]T, self.len)
+	for i := range self.len {
+		values[i] = self.buf[(self.head+i)&self.mask()]
 	}
+	return values
+}
 
-	return rv
+// Checks if two deques contain the same elements in the same order
+func (self *Deque[T]) Equal(other *Deque[T]) bool {
+	if self == other {
+		return true
+	}
+	if self.GetLen() != other.GetLen() {
+		return false
+	}
+
+	otherValues := other.ToSlice()
+
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	for i, value := range otherValues {
+		if self.buf[(self.head+i)&self.mask()] != value {
+			return false
+		}
+	}
+	return true
 }
 
 // NOTE: assumes the mutex is acquired
-func (self *Deque[T]) append(value T) {
-	n := &node[T]{value, nil, nil}
-
-	switch self.GetLen() {
-	case 0:
-		self.head = n
-		self.tail = n
-	case 1:
-		n.prev = self.head
-		self.head.next = n
-		self.tail = n
-	default:
-		n.prev = self.tail
-		self.tail.next = n
-		self.tail = n
+func (self *Deque[T]) growForPush() {
+	if !self.IsUnlimited() || self.len < len(self.buf) {
+		return
 	}
+	self.resize(len(self.buf) * 2)
+}
 
+// NOTE: assumes the mutex is acquired
+func (self *Deque[T]) shrinkAfterPop() {
+	if !self.IsUnlimited() || len(self.buf) <= minBufferSize || self.len > len(self.buf)/4 {
+		return
+	}
+	self.resize(max(len(self.buf)/2, minBufferSize))
+}
+
+// NOTE: assumes the mutex is acquired
+func (self *Deque[T]) resize(newSize int) {
+	buf := make([]T, newSize)
+	for i := range self.len {
+		buf[i] = self.buf[(self.head+i)&self.mask()]
+	}
+	self.buf = buf
+	self.head = 0
+}
+
+// NOTE: assumes the mutex is acquired
+func (self *Deque[T]) append(value T) {
+	self.growForPush()
+
+	self.buf[(self.head+self.len)&self.mask()] = value
 	self.len++
 
 	if self.isOverflowing() {
 		self.tryPopLeft()
 	}
-
 }
 
 // Appends a new element to the right end of the deque.
@@ -160,18 +275,10 @@ func (self *Deque[T]) Append(value T) {
 
 // NOTE: assumes the mutex is acquired
 func (self *Deque[T]) appendLeft(value T) {
-	n := &node[T]{value, nil, nil}
-
-	switch self.GetLen() {
-	case 0:
-		self.head = n
-		self.tail = n
-	default:
-		n.next = self.head
-		self.head.prev = n
-		self.head = n
-	}
+	self.growForPush()
 
+	self.head = (self.head - 1) & self.mask()
+	self.buf[self.head] = value
 	self.len++
 
 	if self.isOverflowing() {
@@ -188,27 +295,78 @@ func (self *Deque[T]) AppendLeft(value T) {
 	self.appendLeft(value)
 }
 
+// Appends all values from seq to the right end of the deque.
+// Respects capacity exactly as Append does: on overflow, elements are evicted from
+// the left end. seq is drained into a slice before the deque's mutex is taken, so
+// it's safe to pass an iterator derived from this same deque, e.g. q.Extend(q.Values())
+func (self *Deque[T]) Extend(seq iter.Seq[T]) {
+	var values []T
+	for value := range seq {
+		values = append(values, value)
+	}
+
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	for _, value := range values {
+		self.append(value)
+	}
+}
+
+// Appends all values from seq to the left end of the deque.
+// Respects capacity exactly as AppendLeft does: on overflow, elements are evicted
+// from the right end. As with collections.deque, this reverses the iteration order,
+// e.g. ExtendLeft over [1, 2, 3] leaves the deque starting with [3, 2, 1, ...].
+// seq is drained into a slice before the deque's mutex is taken, so it's safe to
+// pass an iterator derived from this same deque, e.g. q.ExtendLeft(q.RangeValues(0, 3))
+func (self *Deque[T]) ExtendLeft(seq iter.Seq[T]) {
+	var values []T
+	for value := range seq {
+		values = append(values, value)
+	}
+
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	for _, value := range values {
+		self.appendLeft(value)
+	}
+}
+
+// Appends a copy of other's elements to the right end of the deque, respecting
+// capacity exactly as Append does, and returns the receiver for chaining
+func (self *Deque[T]) Concat(other *Deque[T]) *Deque[T] {
+	values := make([]T, 0, other.GetLen())
+	for value := range other.Values() {
+		values = append(values, value)
+	}
+
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	for _, value := range values {
+		self.append(value)
+	}
+
+	return self
+}
+
 // NOTE: assumes the mutex is acquired
 func (self *Deque[T]) tryPop() (T, error) {
 	var value T
 
-	switch self.GetLen() {
-	case 0:
+	if self.GetLen() == 0 {
 		return value, &PopError{}
-	case 1:
-		self.head = nil
-		self.tail = nil
-	default:
-		oldTail := self.tail
-		newTail := oldTail.prev
-		newTail.next = nil
-		oldTail.next = nil
-		oldTail.prev = nil
-		self.tail.prev = nil
-		self.tail = newTail
 	}
+
+	tailIdx := (self.head + self.len - 1) & self.mask()
+	value = self.buf[tailIdx]
+	var zero T
+	self.buf[tailIdx] = zero
 	self.len--
 
+	self.shrinkAfterPop()
+
 	return value, nil
 }
 
@@ -225,19 +383,18 @@ func (self *Deque[T]) TryPop() (T, error) {
 func (self *Deque[T]) tryPopLeft() (T, error) {
 	var value T
 
-	switch self.GetLen() {
-	case 0:
+	if self.GetLen() == 0 {
 		return value, &PopError{}
-	case 1:
-		self.head = nil
-		self.tail = nil
-	default:
-		newHead := self.head.next
-		newHead.prev = nil
-		self.head = newHead
 	}
+
+	value = self.buf[self.head]
+	var zero T
+	self.buf[self.head] = zero
+	self.head = (self.head + 1) & self.mask()
 	self.len--
 
+	self.shrinkAfterPop()
+
 	return value, nil
 }
 
@@ -250,14 +407,141 @@ func (self *Deque[T]) TryPopLeft() (T, error) {
 	return self.tryPopLeft()
 }
 
+// Inserts a value at the given index, shifting elements from whichever end is
+// closer to the index to make room. Returns a *PeekError if index is not in [0, GetLen()].
+// If the deque is at capacity, an element is evicted from the opposite end, consistent with Append/AppendLeft
+func (self *Deque[T]) Insert(index int, value T) error {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	if index < 0 || index > self.GetLen() {
+		return &PeekError{index}
+	}
+
+	self.growForPush()
+
+	shiftFromHead := 2*index < self.len
+	if shiftFromHead {
+		self.head = (self.head - 1) & self.mask()
+		for i := range index {
+			self.buf[(self.head+i)&self.mask()] = self.buf[(self.head+i+1)&self.mask()]
+		}
+	} else {
+		for i := self.len; i > index; i-- {
+			self.buf[(self.head+i)&self.mask()] = self.buf[(self.head+i-1)&self.mask()]
+		}
+	}
+	self.buf[(self.head+index)&self.mask()] = value
+	self.len++
+
+	if self.isOverflowing() {
+		if shiftFromHead {
+			self.tryPop()
+		} else {
+			self.tryPopLeft()
+		}
+	}
+
+	return nil
+}
+
+// Removes and returns the element at the given index, shifting elements from
+// whichever end is closer to the index to fill the gap.
+// Returns a *PeekError if index is not in [0, GetLen())
+func (self *Deque[T]) Remove(index int) (T, error) {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	var value T
+
+	if index < 0 || index >= self.GetLen() {
+		return value, &PeekError{index}
+	}
+
+	value = self.buf[(self.head+index)&self.mask()]
+	var zero T
+
+	if 2*index < self.len {
+		for i := index; i > 0; i-- {
+			self.buf[(self.head+i)&self.mask()] = self.buf[(self.head+i-1)&self.mask()]
+		}
+		self.buf[self.head] = zero
+		self.head = (self.head + 1) & self.mask()
+	} else {
+		for i := index; i < self.len-1; i++ {
+			self.buf[(self.head+i)&self.mask()] = self.buf[(self.head+i+1)&self.mask()]
+		}
+		self.buf[(self.head+self.len-1)&self.mask()] = zero
+	}
+	self.len--
+
+	self.shrinkAfterPop()
+
+	return value, nil
+}
+
+// Removes the elements in [start, end) from the deque and returns an iterator over
+// the removed values, in order. Unlike Range, the elements are removed immediately
+// when Drain is called, so the deque is left consistent whether the returned
+// iterator is consumed fully, partially, or not at all. Panics if the range is out of bounds
+func (self *Deque[T]) Drain(start, end int) iter.Seq[T] {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	self.validateRange(start, end)
+
+	n := end - start
+	drained := make([]T, n)
+	for i := range n {
+		drained[i] = self.buf[(self.head+start+i)&self.mask()]
+	}
+
+	var zero T
+	suffixLen := self.len - end
+	if start <= suffixLen {
+		// The prefix [0, start) is the shorter side, shift it right to close the gap
+		for i := start - 1; i >= 0; i-- {
+			self.buf[(self.head+i+n)&self.mask()] = self.buf[(self.head+i)&self.mask()]
+		}
+		for i := range n {
+			self.buf[(self.head+i)&self.mask()] = zero
+		}
+		self.head = (self.head + n) & self.mask()
+	} else {
+		// The suffix [end, len) is the shorter side, shift it left to close the gap
+		for i := end; i < self.len; i++ {
+			self.buf[(self.head+i-n)&self.mask()] = self.buf[(self.head+i)&self.mask()]
+		}
+		for i := self.len - n; i < self.len; i++ {
+			self.buf[(self.head+i)&self.mask()] = zero
+		}
+	}
+	self.len -= n
+
+	self.shrinkAfterPop()
+
+	return slices.Values(drained)
+}
+
+// NOTE: assumes the mutex is acquired
+func (self *Deque[T]) reset() {
+	var bufSize int
+	if self.IsUnlimited() {
+		bufSize = minBufferSize
+	} else {
+		bufSize = bufferSizeForCapacity(self.capacity)
+	}
+	self.buf = make([]T, bufSize)
+	self.head = 0
+	self.len = 0
+}
+
 // Removes all elements from the deque
 func (self *Deque[T]) Clear() {
 	self.mutex.Lock()
 	defer self.mutex.Unlock()
 
-	self.head = nil
-	self.tail = nil
-	self.len = 0
+	self.reset()
 }
 
 // Returns the number of occurrences of the value given in the deque
@@ -266,16 +550,63 @@ func (self *Deque[T]) Count(value T) int {
 	defer self.mutex.Unlock()
 
 	i := 0
-	n := self.head
-	for n != nil {
-		if n.value == value {
+	for n := range self.len {
+		if self.buf[(self.head+n)&self.mask()] == value {
 			i++
 		}
-		n = n.next
 	}
 	return i
 }
 
+// Returns the index of the first occurrence of value, searching from the head,
+// and true if found
+func (self *Deque[T]) Index(value T) (int, bool) {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	for i := range self.len {
+		if self.buf[(self.head+i)&self.mask()] == value {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// Returns the index of the last occurrence of value, searching from the tail,
+// and true if found
+func (self *Deque[T]) RIndex(value T) (int, bool) {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	for i := self.len - 1; i >= 0; i-- {
+		if self.buf[(self.head+i)&self.mask()] == value {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// Checks if the deque contains the given value
+func (self *Deque[T]) Contains(value T) bool {
+	_, found := self.Index(value)
+	return found
+}
+
+// Returns the index of the first element for which pred returns true, searching
+// from the head, and true if found. Unlike Index, this does not require value
+// equality, so it works for structs where only a subset of fields identifies a match
+func (self *Deque[T]) IndexFunc(pred func(T) bool) (int, bool) {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	for i := range self.len {
+		if pred(self.buf[(self.head+i)&self.mask()]) {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
 // Returns an element at the given index or error if there's no element at such index
 func (self *Deque[T]) TryPeek(index int) (T, error) {
 	self.mutex.Lock()
@@ -287,21 +618,7 @@ func (self *Deque[T]) TryPeek(index int) (T, error) {
 		return value, &PeekError{index}
 	}
 
-	if index < self.GetLen()/2 {
-		// Start from the head in the index is in the first half
-		n := self.head
-		for range index {
-			n = n.next
-		}
-		value = n.value
-	} else {
-		// Otherwise start from the tail, this gives us O(1) for head/tail lookup
-		n := self.tail
-		for range self.GetLen() - 1 - index {
-			n = n.prev
-		}
-		value = n.value
-	}
+	value = self.buf[(self.head+index)&self.mask()]
 
 	return value, nil
 }
@@ -315,28 +632,26 @@ func (self *Deque[T]) Peek(index int) T {
 	return value
 }
 
-// Rotates the deque to the right, unsafe
+// Rotates the deque to the right by one step, unsafe
 func (self *Deque[T]) rotateRight() {
-	tail := self.tail
-	tail.next = self.head
-	self.tail = tail.prev
-	self.tail.next = nil
-	tail.prev = nil
-	self.head = tail
+	tailIdx := (self.head + self.len - 1) & self.mask()
+	newHead := (self.head - 1) & self.mask()
+	self.buf[newHead] = self.buf[tailIdx]
+	self.head = newHead
 }
 
-// Rotates the deque to the right, unsafe
+// Rotates the deque to the left by one step, unsafe
 func (self *Deque[T]) rotateLeft() {
-	head := self.head
-	self.head = head.next
-	head.next = nil
-	tail := self.tail
-	tail.next = head
-	self.tail = head
+	newTailIdx := (self.head + self.len) & self.mask()
+	self.buf[newTailIdx] = self.buf[self.head]
+	self.head = (self.head + 1) & self.mask()
 }
 
-// Rotates the deque by the given number of steps
-// TODO: optimise: can be done without a loop
+// Rotates the deque by the given number of steps.
+// Positive n rotates right (tail elements move towards the head), negative
+// rotates left. n is normalized modulo the length, and rotation always proceeds
+// in whichever direction is cheaper, since rotating right by n steps is
+// equivalent to rotating left by len-n steps
 func (self *Deque[T]) Rotate(n int) {
 	self.mutex.Lock()
 	defer self.mutex.Unlock()
@@ -345,16 +660,17 @@ func (self *Deque[T]) Rotate(n int) {
 		return
 	}
 
-	var doRotation func()
-	if n >= 0 {
-		doRotation = self.rotateRight
-	} else {
-		doRotation = self.rotateLeft
+	n %= self.len
+	if n < 0 {
+		n += self.len
 	}
 
-	if n < 0 {
-		n = -n
+	doRotation := self.rotateRight
+	if n > self.len-n {
+		n = self.len - n
+		doRotation = self.rotateLeft
 	}
+
 	for range n {
 		doRotation()
 	}
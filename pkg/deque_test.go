@@ -24,11 +24,8 @@ func TestNewDeque(t *testing.T) {
 				if q.GetLen() != 0 {
 					t.Errorf("Expected len to be 0, got %d", q.GetLen())
 				}
-				if q.head != nil {
-					t.Errorf("Expected head to be nil, got %v", q.head)
-				}
-				if q.tail != nil {
-					t.Errorf("Expected tail to be nil, got %v", q.tail)
+				if len(q.buf) == 0 {
+					t.Errorf("Expected backing buffer to be allocated, got empty buffer")
 				}
 			},
 		)
@@ -44,11 +41,8 @@ func TestNewUnlimitedDeque(t *testing.T) {
 	if q.GetLen() != 0 {
 		t.Errorf("Expected len to be 0, got %d", q.GetLen())
 	}
-	if q.head != nil {
-		t.Errorf("Expected head to be nil, got %v", q.head)
-	}
-	if q.tail != nil {
-		t.Errorf("Expected tail to be nil, got %v", q.tail)
+	if len(q.buf) == 0 {
+		t.Errorf("Expected backing buffer to be allocated, got empty buffer")
 	}
 }
 
@@ -239,10 +233,12 @@ func TestAppend(t *testing.T) {
 						t.Errorf("Length is invalid, expected %d, got %d", expectedLen, q.GetLen())
 					}
 
-					if tc.capacity != 0 && q.tail.value != v {
-						t.Errorf("Expected tail to be equal %d, got %d", v, q.tail.value)
-					} else if tc.capacity == 0 && q.tail != nil {
-						t.Errorf("Expected tail to be equal to nil, got %d", q.tail.value)
+					if tc.capacity != 0 {
+						if tail := q.Peek(q.GetLen() - 1); tail != v {
+							t.Errorf("Expected tail to be equal %d, got %d", v, tail)
+						}
+					} else if q.GetLen() != 0 {
+						t.Errorf("Expected deque of capacity 0 to stay empty, got len %d", q.GetLen())
 					}
 				}
 			},
@@ -285,10 +281,12 @@ func TestAppendLeft(t *testing.T) {
 						t.Fatalf("Length is invalid, expected %d, got %d", expectedLen, q.GetLen())
 					}
 
-					if tc.capacity != 0 && q.head.value != v {
-						t.Fatalf("Expected tail to be equal %d, got %d", v, q.head.value)
-					} else if tc.capacity == 0 && q.head != nil {
-						t.Fatalf("Expected tail to be equal to nil, got %d", q.head.value)
+					if tc.capacity != 0 {
+						if head := q.Peek(0); head != v {
+							t.Fatalf("Expected head to be equal %d, got %d", v, head)
+						}
+					} else if q.GetLen() != 0 {
+						t.Fatalf("Expected deque of capacity 0 to stay empty, got len %d", q.GetLen())
 					}
 				}
 			},
@@ -296,6 +294,157 @@ func TestAppendLeft(t *testing.T) {
 	}
 }
 
+func TestResizeGrowAndShrinkWithWrappedHead(t *testing.T) {
+	q := NewDeque[int](-1)
+	var expected []int
+
+	checkMatches := func(t *testing.T) {
+		t.Helper()
+		if q.GetLen() != len(expected) {
+			t.Fatalf("Expected len to be %d, got %d", len(expected), q.GetLen())
+		}
+		for i, want := range expected {
+			if got := q.Peek(i); got != want {
+				t.Fatalf("Expected value at index %d to be %d, got %d", i, want, got)
+			}
+		}
+	}
+
+	// Wrap head around the initial buffer with mixed push ends before growing
+	// past it, so the eventual resize has to unwrap a non-zero head
+	for i := 1; i <= minBufferSize/2; i++ {
+		q.Append(i)
+		expected = append(expected, i)
+	}
+	for i := minBufferSize/2 + 1; i <= minBufferSize; i++ {
+		q.AppendLeft(-i)
+		expected = append([]int{-i}, expected...)
+	}
+	if q.GetLen() != minBufferSize {
+		t.Fatalf("Expected len to be %d, got %d", minBufferSize, q.GetLen())
+	}
+	checkMatches(t)
+
+	// Push well past the initial buffer to force growForPush to double more than once
+	const totalLen = minBufferSize * 8
+	for i := minBufferSize + 1; i <= totalLen; i++ {
+		q.Append(i)
+		expected = append(expected, i)
+	}
+	checkMatches(t)
+
+	// Pop back down past the shrink threshold (len <= cap/4) and confirm order survives
+	for q.GetLen() > totalLen/8 {
+		value, err := q.TryPopLeft()
+		if err != nil {
+			t.Fatalf("Expected TryPopLeft() to succeed, got error: %v", err)
+		}
+		if value != expected[0] {
+			t.Fatalf("Expected popped value to be %d, got %d", expected[0], value)
+		}
+		expected = expected[1:]
+	}
+	checkMatches(t)
+}
+
+func TestExtend(t *testing.T) {
+	q := NewDequeFromSeq(slices.Values([]int{0, 1}), -1)
+	q.Extend(slices.Values([]int{2, 3, 4}))
+
+	expected := []int{0, 1, 2, 3, 4}
+	if q.GetLen() != len(expected) {
+		t.Fatalf("Expected len to be %d, got %d", len(expected), q.GetLen())
+	}
+	for i, v := range expected {
+		if got := q.Peek(i); got != v {
+			t.Errorf("Expected value at index %d to be %d, got %d", i, v, got)
+		}
+	}
+}
+
+func TestExtendOverflow(t *testing.T) {
+	q := NewDequeFromSeq(slices.Values([]int{0, 1, 2}), 3)
+	q.Extend(slices.Values([]int{3, 4}))
+
+	expected := []int{2, 3, 4}
+	if q.GetLen() != len(expected) {
+		t.Fatalf("Expected len to be %d, got %d", len(expected), q.GetLen())
+	}
+	for i, v := range expected {
+		if got := q.Peek(i); got != v {
+			t.Errorf("Expected value at index %d to be %d, got %d", i, v, got)
+		}
+	}
+}
+
+func TestExtendLeft(t *testing.T) {
+	q := NewDequeFromSeq(slices.Values([]int{3, 4}), -1)
+	q.ExtendLeft(slices.Values([]int{2, 1, 0}))
+
+	expected := []int{0, 1, 2, 3, 4}
+	if q.GetLen() != len(expected) {
+		t.Fatalf("Expected len to be %d, got %d", len(expected), q.GetLen())
+	}
+	for i, v := range expected {
+		if got := q.Peek(i); got != v {
+			t.Errorf("Expected value at index %d to be %d, got %d", i, v, got)
+		}
+	}
+}
+
+func TestExtendWithOwnIterator(t *testing.T) {
+	q := NewDequeFromSeq(slices.Values([]int{0, 1, 2}), -1)
+	q.Extend(q.Values())
+
+	expected := []int{0, 1, 2, 0, 1, 2}
+	if q.GetLen() != len(expected) {
+		t.Fatalf("Expected len to be %d, got %d", len(expected), q.GetLen())
+	}
+	for i, v := range expected {
+		if got := q.Peek(i); got != v {
+			t.Errorf("Expected value at index %d to be %d, got %d", i, v, got)
+		}
+	}
+}
+
+func TestExtendLeftWithOwnIterator(t *testing.T) {
+	q := NewDequeFromSeq(slices.Values([]int{0, 1, 2}), -1)
+	q.ExtendLeft(q.RangeValues(0, 3))
+
+	expected := []int{2, 1, 0, 0, 1, 2}
+	if q.GetLen() != len(expected) {
+		t.Fatalf("Expected len to be %d, got %d", len(expected), q.GetLen())
+	}
+	for i, v := range expected {
+		if got := q.Peek(i); got != v {
+			t.Errorf("Expected value at index %d to be %d, got %d", i, v, got)
+		}
+	}
+}
+
+func TestConcat(t *testing.T) {
+	q1 := NewDequeFromSeq(slices.Values([]int{0, 1, 2}), -1)
+	q2 := NewDequeFromSeq(slices.Values([]int{3, 4}), -1)
+
+	rv := q1.Concat(q2)
+	if rv != q1 {
+		t.Errorf("Expected Concat() to return the receiver")
+	}
+
+	expected := []int{0, 1, 2, 3, 4}
+	if q1.GetLen() != len(expected) {
+		t.Fatalf("Expected len to be %d, got %d", len(expected), q1.GetLen())
+	}
+	for i, v := range expected {
+		if got := q1.Peek(i); got != v {
+			t.Errorf("Expected value at index %d to be %d, got %d", i, v, got)
+		}
+	}
+	if q2.GetLen() != 2 {
+		t.Errorf("Expected other deque to be left untouched, got len %d", q2.GetLen())
+	}
+}
+
 func TestTryPop(t *testing.T) {
 	q := NewDeque[float32](8)
 	_, err := q.TryPop()
@@ -427,6 +576,50 @@ func TestCount(t *testing.T) {
 	)
 }
 
+func TestIndex(t *testing.T) {
+	q := NewDequeFromSeq(slices.Values([]int{5, 1, 2, 1, 3}), -1)
+
+	if idx, found := q.Index(1); !found || idx != 1 {
+		t.Errorf("Expected Index(1) to return (1, true), got (%d, %v)", idx, found)
+	}
+	if idx, found := q.Index(9); found {
+		t.Errorf("Expected Index(9) to return found=false, got (%d, %v)", idx, found)
+	}
+}
+
+func TestRIndex(t *testing.T) {
+	q := NewDequeFromSeq(slices.Values([]int{5, 1, 2, 1, 3}), -1)
+
+	if idx, found := q.RIndex(1); !found || idx != 3 {
+		t.Errorf("Expected RIndex(1) to return (3, true), got (%d, %v)", idx, found)
+	}
+	if idx, found := q.RIndex(9); found {
+		t.Errorf("Expected RIndex(9) to return found=false, got (%d, %v)", idx, found)
+	}
+}
+
+func TestContains(t *testing.T) {
+	q := NewDequeFromSeq(slices.Values([]int{5, 1, 2}), -1)
+
+	if !q.Contains(2) {
+		t.Errorf("Expected Contains(2) to return true")
+	}
+	if q.Contains(9) {
+		t.Errorf("Expected Contains(9) to return false")
+	}
+}
+
+func TestIndexFunc(t *testing.T) {
+	q := NewDequeFromSeq(slices.Values([]int{5, 1, 2, 1, 3}), -1)
+
+	if idx, found := q.IndexFunc(func(v int) bool { return v > 2 }); !found || idx != 0 {
+		t.Errorf("Expected IndexFunc() to return (0, true), got (%d, %v)", idx, found)
+	}
+	if idx, found := q.IndexFunc(func(v int) bool { return v > 10 }); found {
+		t.Errorf("Expected IndexFunc() to return found=false, got (%d, %v)", idx, found)
+	}
+}
+
 func TestIsEmpty(t *testing.T) {
 	q := NewUnlimitedDeque[string]()
 	if !q.IsEmpty() {
@@ -475,6 +668,69 @@ func TestCopy(t *testing.T) {
 	}
 }
 
+func TestClone(t *testing.T) {
+	q := NewDequeFromSeq(slices.Values([]int{1, 2, 3}), -1)
+	nq := q.Clone()
+
+	if !q.Equal(nq) {
+		t.Errorf("Expected clone to equal the original")
+	}
+
+	nq.Append(4)
+	if q.Equal(nq) {
+		t.Errorf("Expected mutating the clone to leave the original untouched")
+	}
+}
+
+func TestToSlice(t *testing.T) {
+	values := []int{1, 2, 3}
+	q := NewDequeFromSeq(slices.Values(values), -1)
+
+	if got := q.ToSlice(); !slices.Equal(got, values) {
+		t.Errorf("Expected ToSlice() to return %v, got %v", values, got)
+	}
+}
+
+func TestEqual(t *testing.T) {
+	q1 := NewDequeFromSeq(slices.Values([]int{1, 2, 3}), -1)
+
+	t.Run(
+		"equal-deques",
+		func(t *testing.T) {
+			q2 := NewDequeFromSeq(slices.Values([]int{1, 2, 3}), 10)
+			if !q1.Equal(q2) {
+				t.Errorf("Expected deques with the same elements to be equal")
+			}
+		},
+	)
+	t.Run(
+		"different-length",
+		func(t *testing.T) {
+			q2 := NewDequeFromSeq(slices.Values([]int{1, 2}), -1)
+			if q1.Equal(q2) {
+				t.Errorf("Expected deques of different length to not be equal")
+			}
+		},
+	)
+	t.Run(
+		"different-order",
+		func(t *testing.T) {
+			q2 := NewDequeFromSeq(slices.Values([]int{3, 2, 1}), -1)
+			if q1.Equal(q2) {
+				t.Errorf("Expected deques with elements in different order to not be equal")
+			}
+		},
+	)
+	t.Run(
+		"same-instance",
+		func(t *testing.T) {
+			if !q1.Equal(q1) {
+				t.Errorf("Expected a deque to equal itself")
+			}
+		},
+	)
+}
+
 func TestRotate(t *testing.T) {
 	const CAPACITY int = 3
 	q := NewDeque[int](CAPACITY)
@@ -494,3 +750,322 @@ func TestRotate(t *testing.T) {
 		t.Errorf("Expected first element to be %d, got %d", CAPACITY-1, v)
 	}
 }
+
+func TestRotateNormalizesLargeN(t *testing.T) {
+	const SIZE int = 5
+
+	q := NewDequeFromSeq(slices.Values([]int{0, 1, 2, 3, 4}), -1)
+	expected := q.Copy()
+	expected.Rotate(2)
+
+	q.Rotate(2 + SIZE*1_000_000)
+
+	for i := range SIZE {
+		if got, want := q.Peek(i), expected.Peek(i); got != want {
+			t.Errorf("Expected value at index %d to be %d, got %d", i, want, got)
+		}
+	}
+}
+
+func TestRotateEquivalentDirections(t *testing.T) {
+	const SIZE int = 5
+
+	testCases := []int{1, 2, 3, 4, -1, -2, -3, -4}
+	for _, n := range testCases {
+		t.Run(
+			fmt.Sprintf("N/%d", n),
+			func(t *testing.T) {
+				right := NewDequeFromSeq(slices.Values([]int{0, 1, 2, 3, 4}), -1)
+				left := NewDequeFromSeq(slices.Values([]int{0, 1, 2, 3, 4}), -1)
+
+				right.Rotate(n)
+				left.Rotate(n - SIZE)
+
+				for i := range SIZE {
+					if got, want := left.Peek(i), right.Peek(i); got != want {
+						t.Errorf("Rotate(%d) and Rotate(%d) diverged at index %d: %d != %d", n, n-SIZE, i, want, got)
+					}
+				}
+			},
+		)
+	}
+}
+
+func TestInsert(t *testing.T) {
+	testCases := []struct {
+		initial  []int
+		index    int
+		value    int
+		expected []int
+	}{
+		{[]int{0, 1, 2, 3}, 0, 99, []int{99, 0, 1, 2, 3}},
+		{[]int{0, 1, 2, 3}, 1, 99, []int{0, 99, 1, 2, 3}},
+		{[]int{0, 1, 2, 3}, 2, 99, []int{0, 1, 99, 2, 3}},
+		{[]int{0, 1, 2, 3}, 3, 99, []int{0, 1, 2, 99, 3}},
+		{[]int{0, 1, 2, 3}, 4, 99, []int{0, 1, 2, 3, 99}},
+		{[]int{}, 0, 99, []int{99}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(
+			fmt.Sprintf("Index/%d", tc.index),
+			func(t *testing.T) {
+				q := NewDequeFromSeq(slices.Values(tc.initial), -1)
+
+				if err := q.Insert(tc.index, tc.value); err != nil {
+					t.Fatalf("Expected Insert() to succeed, got error: %v", err)
+				}
+				if q.GetLen() != len(tc.expected) {
+					t.Fatalf("Expected len to be %d, got %d", len(tc.expected), q.GetLen())
+				}
+				for i, v := range tc.expected {
+					if got := q.Peek(i); got != v {
+						t.Errorf("Expected value at index %d to be %d, got %d", i, v, got)
+					}
+				}
+			},
+		)
+	}
+}
+
+func TestInsertOutOfBounds(t *testing.T) {
+	q := NewDequeFromSeq(slices.Values([]int{0, 1, 2}), -1)
+
+	for _, idx := range []int{-1, 4} {
+		if err := q.Insert(idx, 99); err == nil {
+			t.Errorf("Expected Insert() to error out at index %d", idx)
+		}
+	}
+}
+
+func TestInsertAtCapacity(t *testing.T) {
+	const CAPACITY int = 4
+
+	t.Run(
+		"shift-from-head-evicts-tail",
+		func(t *testing.T) {
+			q := NewDequeFromSeq(slices.Values([]int{0, 1, 2, 3}), CAPACITY)
+			if err := q.Insert(0, 99); err != nil {
+				t.Fatalf("Expected Insert() to succeed, got error: %v", err)
+			}
+			if q.GetLen() != CAPACITY {
+				t.Fatalf("Expected len to stay %d, got %d", CAPACITY, q.GetLen())
+			}
+			expected := []int{99, 0, 1, 2}
+			for i, v := range expected {
+				if got := q.Peek(i); got != v {
+					t.Errorf("Expected value at index %d to be %d, got %d", i, v, got)
+				}
+			}
+		},
+	)
+	t.Run(
+		"shift-from-tail-evicts-head",
+		func(t *testing.T) {
+			q := NewDequeFromSeq(slices.Values([]int{0, 1, 2, 3}), CAPACITY)
+			if err := q.Insert(CAPACITY, 99); err != nil {
+				t.Fatalf("Expected Insert() to succeed, got error: %v", err)
+			}
+			if q.GetLen() != CAPACITY {
+				t.Fatalf("Expected len to stay %d, got %d", CAPACITY, q.GetLen())
+			}
+			expected := []int{1, 2, 3, 99}
+			for i, v := range expected {
+				if got := q.Peek(i); got != v {
+					t.Errorf("Expected value at index %d to be %d, got %d", i, v, got)
+				}
+			}
+		},
+	)
+	t.Run(
+		"capacity-one-insert-at-front-keeps-new-value",
+		func(t *testing.T) {
+			q := NewDeque[int](1)
+			q.Append(0)
+			if err := q.Insert(0, 99); err != nil {
+				t.Fatalf("Expected Insert() to succeed, got error: %v", err)
+			}
+			if q.GetLen() != 1 {
+				t.Fatalf("Expected len to stay 1, got %d", q.GetLen())
+			}
+			if got := q.Peek(0); got != 99 {
+				t.Errorf("Expected value at index 0 to be 99, got %d", got)
+			}
+		},
+	)
+}
+
+func TestRemove(t *testing.T) {
+	testCases := []struct {
+		initial  []int
+		index    int
+		expected []int
+		removed  int
+	}{
+		{[]int{0, 1, 2, 3}, 0, []int{1, 2, 3}, 0},
+		{[]int{0, 1, 2, 3}, 1, []int{0, 2, 3}, 1},
+		{[]int{0, 1, 2, 3}, 2, []int{0, 1, 3}, 2},
+		{[]int{0, 1, 2, 3}, 3, []int{0, 1, 2}, 3},
+	}
+
+	for _, tc := range testCases {
+		t.Run(
+			fmt.Sprintf("Index/%d", tc.index),
+			func(t *testing.T) {
+				q := NewDequeFromSeq(slices.Values(tc.initial), -1)
+
+				v, err := q.Remove(tc.index)
+				if err != nil {
+					t.Fatalf("Expected Remove() to succeed, got error: %v", err)
+				}
+				if v != tc.removed {
+					t.Errorf("Expected removed value to be %d, got %d", tc.removed, v)
+				}
+				if q.GetLen() != len(tc.expected) {
+					t.Fatalf("Expected len to be %d, got %d", len(tc.expected), q.GetLen())
+				}
+				for i, v := range tc.expected {
+					if got := q.Peek(i); got != v {
+						t.Errorf("Expected value at index %d to be %d, got %d", i, v, got)
+					}
+				}
+			},
+		)
+	}
+}
+
+func TestRemoveOutOfBounds(t *testing.T) {
+	q := NewDequeFromSeq(slices.Values([]int{0, 1, 2}), -1)
+
+	for _, idx := range []int{-1, 3, 7} {
+		if _, err := q.Remove(idx); err == nil {
+			t.Errorf("Expected Remove() to error out at index %d", idx)
+		}
+	}
+}
+
+func TestRange(t *testing.T) {
+	q := NewDequeFromSeq(slices.Values([]int{0, 1, 2, 3, 4}), -1)
+
+	testCases := []struct{ start, end int }{
+		{0, 5},
+		{1, 4},
+		{2, 2},
+		{0, 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(
+			fmt.Sprintf("Start/%d/End/%d", tc.start, tc.end),
+			func(t *testing.T) {
+				i := tc.start
+				for idx, v := range q.Range(tc.start, tc.end) {
+					if idx != i {
+						t.Errorf("Expected index %d, got %d", i, idx)
+					}
+					if v != i {
+						t.Errorf("Expected value %d, got %d", i, v)
+					}
+					i++
+				}
+				if i != tc.end {
+					t.Errorf("Expected to stop at %d, stopped at %d", tc.end, i)
+				}
+			},
+		)
+	}
+}
+
+func TestRangeOutOfBounds(t *testing.T) {
+	q := NewDequeFromSeq(slices.Values([]int{0, 1, 2}), -1)
+
+	testCases := []struct{ start, end int }{
+		{-1, 2},
+		{0, 4},
+		{2, 1},
+	}
+
+	for _, tc := range testCases {
+		t.Run(
+			fmt.Sprintf("Start/%d/End/%d", tc.start, tc.end),
+			func(t *testing.T) {
+				defer func() {
+					if recover() == nil {
+						t.Errorf("Expected Range() to panic for [%d:%d)", tc.start, tc.end)
+					}
+				}()
+				for range q.Range(tc.start, tc.end) {
+				}
+			},
+		)
+	}
+}
+
+func TestRangeValues(t *testing.T) {
+	q := NewDequeFromSeq(slices.Values([]int{0, 1, 2, 3, 4}), -1)
+
+	i := 1
+	for v := range q.RangeValues(1, 4) {
+		if v != i {
+			t.Errorf("Expected value %d, got %d", i, v)
+		}
+		i++
+	}
+	if i != 4 {
+		t.Errorf("Expected to stop at 4, stopped at %d", i)
+	}
+}
+
+func TestDrain(t *testing.T) {
+	testCases := []struct {
+		initial        []int
+		start, end     int
+		expectedDrain  []int
+		expectedRemain []int
+	}{
+		{[]int{0, 1, 2, 3, 4}, 1, 3, []int{1, 2}, []int{0, 3, 4}},
+		{[]int{0, 1, 2, 3, 4}, 2, 4, []int{2, 3}, []int{0, 1, 4}},
+		{[]int{0, 1, 2, 3, 4}, 0, 5, []int{0, 1, 2, 3, 4}, []int{}},
+		{[]int{0, 1, 2, 3, 4}, 2, 2, []int{}, []int{0, 1, 2, 3, 4}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(
+			fmt.Sprintf("Start/%d/End/%d", tc.start, tc.end),
+			func(t *testing.T) {
+				q := NewDequeFromSeq(slices.Values(tc.initial), -1)
+
+				drained := slices.Collect(q.Drain(tc.start, tc.end))
+				if !slices.Equal(drained, tc.expectedDrain) {
+					t.Errorf("Expected drained values %v, got %v", tc.expectedDrain, drained)
+				}
+				if q.GetLen() != len(tc.expectedRemain) {
+					t.Fatalf("Expected len to be %d, got %d", len(tc.expectedRemain), q.GetLen())
+				}
+				for i, v := range tc.expectedRemain {
+					if got := q.Peek(i); got != v {
+						t.Errorf("Expected value at index %d to be %d, got %d", i, v, got)
+					}
+				}
+			},
+		)
+	}
+}
+
+func TestDrainStopsEarlyLeavesDequeConsistent(t *testing.T) {
+	q := NewDequeFromSeq(slices.Values([]int{0, 1, 2, 3, 4}), -1)
+
+	for range q.Drain(1, 4) {
+		break
+	}
+
+	expected := []int{0, 4}
+	if q.GetLen() != len(expected) {
+		t.Fatalf("Expected len to be %d, got %d", len(expected), q.GetLen())
+	}
+	for i, v := range expected {
+		if got := q.Peek(i); got != v {
+			t.Errorf("Expected value at index %d to be %d, got %d", i, v, got)
+		}
+	}
+}